@@ -0,0 +1,67 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/shadowscatcher/shodan/models"
+)
+
+// AlertIterator walks the account's network alerts. The underlying
+// /shodan/alert/info endpoint isn't paginated, so this fetches the full
+// listing once on the first Next call, but keeps the same Next/Value/Err
+// shape as the other iterators for consistency.
+type AlertIterator struct {
+	alerts  *AlertsService
+	items   []models.AlertDetails
+	idx     int
+	value   models.AlertDetails
+	err     error
+	fetched bool
+}
+
+// Iterate returns an AlertIterator over every network alert on the account.
+func (s *AlertsService) Iterate(ctx context.Context) *AlertIterator {
+	return &AlertIterator{alerts: s}
+}
+
+// Next advances the iterator. It returns false once every alert has been
+// visited or an error occurred; check Err() to tell the two apart.
+func (it *AlertIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if !it.fetched {
+		items, _, err := it.alerts.ListAlerts(ctx, AlertListOpts{})
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+		it.fetched = true
+	}
+
+	if it.idx >= len(it.items) {
+		return false
+	}
+
+	it.value = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+// Value returns the alert the iterator is currently positioned at.
+func (it *AlertIterator) Value() models.AlertDetails {
+	return it.value
+}
+
+// Err returns the error encountered while fetching the listing, if any.
+func (it *AlertIterator) Err() error {
+	return it.err
+}
+
+// PageInfo reports the total number of alerts. Page is always 1 since the
+// listing endpoint has no pagination.
+func (it *AlertIterator) PageInfo() PageInfo {
+	return PageInfo{Page: 1, Total: len(it.items)}
+}
@@ -0,0 +1,156 @@
+package shodan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+var errEmptyAlertID = errors.New("empty alert id")
+var errEmptyTriggerName = errors.New("empty trigger name")
+var errEmptyService = errors.New("empty service")
+
+// AlertsService handles communication with the network alert and trigger
+// endpoints, rooted at /shodan/alert.
+type AlertsService struct {
+	core *core
+}
+
+// CreateAlert allows to create a network alert for a defined IP/ netblock which can be used to subscribe
+// to changes/events that are discovered within that range
+func (s *AlertsService) CreateAlert(ctx context.Context, alert models.Alert) (result models.AlertDetails, resp *Response, err error) {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	resp, err = s.core.request(ctx, http.MethodPost, routes.ShodanAlert, nil, bytes.NewReader(body), header, &result)
+	return
+}
+
+// AlertInfo returns the information about a specific network alert
+func (s *AlertsService) AlertInfo(ctx context.Context, alertID string) (result models.AlertDetails, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+	route := fmt.Sprintf(routes.ShodanAlertIdInfo, alertID)
+
+	resp, err = s.core.get(ctx, route, nil, &result)
+	return
+}
+
+// DeleteAlert allows to remove the specified network alert
+func (s *AlertsService) DeleteAlert(ctx context.Context, alertID string) (result interface{}, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanAlertId, alertID)
+	resp, err = s.core.request(ctx, http.MethodDelete, route, nil, nil, nil, &result)
+	return
+}
+
+// ListAlerts returns a listing of all the network alerts that are currently active on the account
+func (s *AlertsService) ListAlerts(ctx context.Context, opts AlertListOpts) (result []models.AlertDetails, resp *Response, err error) {
+	params, err := toValues(opts)
+	if err != nil {
+		return
+	}
+	resp, err = s.core.get(ctx, routes.ShodanAlertInfo, params, &result)
+	return
+}
+
+// ListTriggers returns a list of all the triggers that can be enabled on network alerts
+func (s *AlertsService) ListTriggers(ctx context.Context) (result []models.Trigger, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanAlertTriggers, nil, &result)
+	return
+}
+
+// CreateAlertTrigger allows to get notifications when the specified trigger is met
+func (s *AlertsService) CreateAlertTrigger(ctx context.Context, alertID, triggerName string) (result models.SimpleResponse, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+
+	if triggerName == "" {
+		err = errEmptyTriggerName
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanAlertTriggerAction, alertID, triggerName)
+	resp, err = s.core.request(ctx, http.MethodPut, route, nil, nil, nil, &result)
+	return
+}
+
+// DeleteAlertTrigger stops notifications for the specified trigger
+func (s *AlertsService) DeleteAlertTrigger(ctx context.Context, alertID, triggerName string) (result models.SimpleResponse, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+
+	if triggerName == "" {
+		err = errEmptyTriggerName
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanAlertTriggerAction, alertID, triggerName)
+	resp, err = s.core.request(ctx, http.MethodDelete, route, nil, nil, nil, &result)
+	return
+}
+
+// CreateTriggerIgnore allows to ignore the specified service when it is matched for the trigger
+func (s *AlertsService) CreateTriggerIgnore(ctx context.Context, alertID, triggerName, service string) (result models.SimpleResponse, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+
+	if triggerName == "" {
+		err = errEmptyTriggerName
+		return
+	}
+
+	if service == "" {
+		err = errEmptyService
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanAlertTriggerNotificationAction, alertID, triggerName, service)
+	resp, err = s.core.request(ctx, http.MethodPut, route, nil, nil, nil, &result)
+	return
+}
+
+// DeleteTriggerIgnore enables notifications again for the specified trigger
+func (s *AlertsService) DeleteTriggerIgnore(ctx context.Context, alertID, triggerName, service string) (result models.SimpleResponse, resp *Response, err error) {
+	if alertID == "" {
+		err = errEmptyAlertID
+		return
+	}
+
+	if triggerName == "" {
+		err = errEmptyTriggerName
+		return
+	}
+
+	if service == "" {
+		err = errEmptyService
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanAlertTriggerNotificationAction, alertID, triggerName, service)
+	resp, err = s.core.request(ctx, http.MethodDelete, route, nil, nil, nil, &result)
+	return
+}
@@ -0,0 +1,168 @@
+package shodan
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/shadowscatcher/shodan/models"
+)
+
+const (
+	defaultBulkConcurrency = 8
+	defaultBulkRatePerSec  = 1
+)
+
+// BulkOpts tunes the concurrency and rate limiting of the bulk alert
+// operations.
+type BulkOpts struct {
+	// Concurrency caps the number of in-flight requests. Defaults to 8.
+	Concurrency int
+	// RatePerSecond caps how many requests are issued per second, to stay
+	// under Shodan's per-key rate limit. Defaults to 1.
+	RatePerSecond float64
+}
+
+func (o BulkOpts) withDefaults() BulkOpts {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultBulkConcurrency
+	}
+	if o.RatePerSecond <= 0 {
+		o.RatePerSecond = defaultBulkRatePerSec
+	}
+	return o
+}
+
+// BulkOperation names the action a BulkResult reports on.
+type BulkOperation string
+
+const (
+	BulkOperationClear  BulkOperation = "clear"
+	BulkOperationCreate BulkOperation = "create"
+)
+
+// BulkResult reports the outcome of a single alert operation within a bulk
+// call, published as soon as that alert's request completes.
+type BulkResult struct {
+	AlertID   string
+	Operation BulkOperation
+	Err       error
+}
+
+// ClearAll deletes every network alert currently on the account, fanning
+// the deletes out across a worker pool capped at opts.Concurrency and
+// throttled to opts.RatePerSecond requests/sec. Each alert's outcome is
+// published on the returned channel as soon as it completes; the channel
+// is closed once every alert has been processed or ctx is cancelled.
+func (s *AlertsService) ClearAll(ctx context.Context, opts BulkOpts) <-chan BulkResult {
+	opts = opts.withDefaults()
+	results := make(chan BulkResult)
+
+	go func() {
+		defer close(results)
+
+		alerts, _, err := s.ListAlerts(ctx, AlertListOpts{})
+		if err != nil {
+			publish(ctx, results, BulkResult{Operation: BulkOperationClear, Err: err})
+			return
+		}
+
+		ids := make([]string, len(alerts))
+		for i, alert := range alerts {
+			ids[i] = alert.ID
+		}
+
+		runBulk(ctx, ids, opts, func(ctx context.Context, alertID string) error {
+			_, _, err := s.DeleteAlert(ctx, alertID)
+			return err
+		}, BulkOperationClear, results)
+	}()
+
+	return results
+}
+
+// BulkCreate creates every alert in the given slice, fanning the creates
+// out across a worker pool capped at opts.Concurrency and throttled to
+// opts.RatePerSecond requests/sec. Each alert's outcome is published on the
+// returned channel as soon as it completes; the channel is closed once
+// every alert has been processed or ctx is cancelled.
+func (s *AlertsService) BulkCreate(ctx context.Context, alerts []models.Alert, opts BulkOpts) <-chan BulkResult {
+	opts = opts.withDefaults()
+	results := make(chan BulkResult)
+
+	go func() {
+		defer close(results)
+
+		limiter := rate.NewLimiter(rate.Limit(opts.RatePerSecond), 1)
+		sem := make(chan struct{}, opts.Concurrency)
+		var wg sync.WaitGroup
+
+		for _, alert := range alerts {
+			if ctx.Err() != nil {
+				break
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(alert models.Alert) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if err := limiter.Wait(ctx); err != nil {
+					publish(ctx, results, BulkResult{AlertID: alert.Name, Operation: BulkOperationCreate, Err: err})
+					return
+				}
+
+				created, _, err := s.CreateAlert(ctx, alert)
+				id := created.ID
+				if err != nil {
+					id = alert.Name
+				}
+				publish(ctx, results, BulkResult{AlertID: id, Operation: BulkOperationCreate, Err: err})
+			}(alert)
+		}
+
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// runBulk fans work out across a worker pool capped at opts.Concurrency,
+// rate limited to opts.RatePerSecond, publishing one BulkResult per item.
+func runBulk(ctx context.Context, ids []string, opts BulkOpts, do func(ctx context.Context, alertID string) error, op BulkOperation, results chan<- BulkResult) {
+	limiter := rate.NewLimiter(rate.Limit(opts.RatePerSecond), 1)
+	sem := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := limiter.Wait(ctx); err != nil {
+				publish(ctx, results, BulkResult{AlertID: id, Operation: op, Err: err})
+				return
+			}
+
+			err := do(ctx, id)
+			publish(ctx, results, BulkResult{AlertID: id, Operation: op, Err: err})
+		}(id)
+	}
+
+	wg.Wait()
+}
+
+func publish(ctx context.Context, results chan<- BulkResult, result BulkResult) {
+	select {
+	case results <- result:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,200 @@
+package shodan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/shadowscatcher/shodan/routes"
+	"github.com/shadowscatcher/shodan/stream"
+)
+
+// Client is the entry point for the Shodan API. It is a thin wrapper around
+// a shared transport core with one sub-service per resource group, mirroring
+// the way the API itself is organized into /shodan, /dns, /org, etc.
+type Client struct {
+	core *core
+
+	Hosts    *HostsService
+	Scans    *ScansService
+	Alerts   *AlertsService
+	DNS      *DNSService
+	Org      *OrgService
+	Queries  *QueriesService
+	Data     *DataService
+	Exploits *ExploitsService
+	Tools    *ToolsService
+	Stream   *stream.Client
+}
+
+// core holds everything the sub-services need to talk to the API and is
+// never exposed to callers directly.
+type core struct {
+	key         string
+	baseURL     string
+	exploitsURL string
+	streamURL   string
+	httpClient  *http.Client
+}
+
+// ClientOption configures a Client at construction time.
+type ClientOption func(*core)
+
+// WithBaseURL overrides the base URL for the main Shodan API, pointing the
+// Client at something other than routes.ApiRoot. This is mainly useful for
+// tests, e.g. to run against an httptest.Server.
+func WithBaseURL(url string) ClientOption {
+	return func(c *core) { c.baseURL = url }
+}
+
+// WithExploitsURL overrides the base URL for the exploits API, pointing the
+// Client at something other than routes.ApiExploits.
+func WithExploitsURL(url string) ClientOption {
+	return func(c *core) { c.exploitsURL = url }
+}
+
+// WithStreamURL overrides the base URL for the Streaming API that backs
+// Client.Stream, pointing it at something other than routes.ApiStream.
+func WithStreamURL(url string) ClientOption {
+	return func(c *core) { c.streamURL = url }
+}
+
+// NewClient builds a Client authenticated with the given Shodan API key.
+// Passing a custom httpClient (e.g. one with a custom http.RoundTripper)
+// gives tests and instrumentation a seam to intercept every request the
+// Client makes.
+func NewClient(key string, httpClient *http.Client, opts ...ClientOption) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	c := &core{
+		key:         key,
+		baseURL:     routes.ApiRoot,
+		exploitsURL: routes.ApiExploits,
+		streamURL:   routes.ApiStream,
+		httpClient:  httpClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	streamOpts := []stream.ClientOption{stream.WithBaseURL(c.streamURL)}
+
+	return &Client{
+		core:     c,
+		Hosts:    &HostsService{core: c},
+		Scans:    &ScansService{core: c},
+		Alerts:   &AlertsService{core: c},
+		DNS:      &DNSService{core: c},
+		Org:      &OrgService{core: c},
+		Queries:  &QueriesService{core: c},
+		Data:     &DataService{core: c},
+		Exploits: &ExploitsService{core: c},
+		Tools:    &ToolsService{core: c},
+		Stream:   stream.NewClient(key, httpClient, stream.DefaultStreamOptions(), streamOpts...),
+	}
+}
+
+// HTTPClient exposes the underlying *http.Client so callers can swap in a
+// custom http.RoundTripper, e.g. for tests or for adding retry middleware.
+func (c *Client) HTTPClient() *http.Client {
+	return c.core.httpClient
+}
+
+// RateLimit carries the quota information Shodan reports on every response,
+// parsed from the rate-limit headers when present.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     int
+}
+
+// Response wraps the raw *http.Response returned by the API together with
+// the parsed rate-limit information, so callers can inspect HTTP metadata
+// without having to re-parse headers themselves.
+type Response struct {
+	*http.Response
+	RateLimit RateLimit
+}
+
+func newResponse(r *http.Response) *Response {
+	resp := &Response{Response: r}
+	resp.RateLimit.Limit, _ = strconv.Atoi(r.Header.Get("X-RateLimit-Limit"))
+	resp.RateLimit.Remaining, _ = strconv.Atoi(r.Header.Get("X-RateLimit-Remaining"))
+	resp.RateLimit.Reset, _ = strconv.Atoi(r.Header.Get("X-RateLimit-Reset"))
+	return resp
+}
+
+// get performs a GET request against the core API and decodes the JSON body
+// into result.
+func (c *core) get(ctx context.Context, route string, params url.Values, result interface{}) (*Response, error) {
+	return c.do(ctx, http.MethodGet, c.baseURL, route, params, nil, nil, result)
+}
+
+// request performs an arbitrary HTTP request against the core API.
+func (c *core) request(ctx context.Context, method, route string, params url.Values, body io.Reader, header http.Header, result interface{}) (*Response, error) {
+	return c.do(ctx, method, c.baseURL, route, params, body, header, result)
+}
+
+// requestExploits performs a request against the exploits API, which is
+// hosted on a separate domain from the rest of Shodan's endpoints.
+func (c *core) requestExploits(ctx context.Context, method, route string, params url.Values, body io.Reader, header http.Header, result interface{}) (*Response, error) {
+	return c.do(ctx, method, c.exploitsURL, route, params, body, header, result)
+}
+
+func (c *core) do(ctx context.Context, method, base, route string, params url.Values, body io.Reader, header http.Header, result interface{}) (*Response, error) {
+	if params == nil {
+		params = make(url.Values)
+	}
+	params.Set("key", c.key)
+
+	fullURL := base + route
+	if method == http.MethodGet {
+		fullURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if method != http.MethodGet {
+		req.URL.RawQuery = params.Encode()
+	}
+
+	for k, values := range header {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp := newResponse(httpResp)
+
+	payload, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return resp, fmt.Errorf("shodan: %s %s: %s", method, route, bytes.TrimSpace(payload))
+	}
+
+	if result == nil || len(payload) == 0 {
+		return resp, nil
+	}
+
+	return resp, json.Unmarshal(payload, result)
+}
@@ -0,0 +1,34 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// DataService handles communication with the bulk data download endpoints,
+// rooted at /shodan/data.
+type DataService struct {
+	core *core
+}
+
+// Datasets allows to see a list of the datasets that are available for download
+func (s *DataService) Datasets(ctx context.Context) (result []models.Dataset, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanData, nil, &result)
+	return
+}
+
+// DatasetFiles alloows to get a list of files that are available for download from the provided dataset
+func (s *DataService) DatasetFiles(ctx context.Context, dataset string) (result []models.DatasetFile, resp *Response, err error) {
+	if dataset == "" {
+		err = errors.New("empty dataset id")
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanDataset, dataset)
+	resp, err = s.core.get(ctx, route, nil, &result)
+	return
+}
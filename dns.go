@@ -0,0 +1,74 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+var errBigRequest = errors.New("request is too big")
+
+const (
+	hostnamesLenLimit = 3575
+	ipsLenLimit       = 3369
+)
+
+// DNSService handles communication with the DNS lookup endpoints, rooted
+// at /dns.
+type DNSService struct {
+	core *core
+}
+
+// DnsResolve looks up the IP address for the provided list of hostnames
+func (s *DNSService) DnsResolve(ctx context.Context, hostnames []string) (result map[string]string, resp *Response, err error) {
+	if len(hostnames) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	joined := strings.Join(hostnames, ",")
+	if len(joined) > hostnamesLenLimit {
+		err = errBigRequest
+		return
+	}
+
+	params := make(url.Values)
+	params.Set("hostnames", joined)
+	resp, err = s.core.get(ctx, routes.DnsResolve, params, &result)
+	return
+}
+
+// DnsReverse looks up the hostnames that have been defined for the given list of IP addresses
+func (s *DNSService) DnsReverse(ctx context.Context, ips []string) (result map[string][]string, resp *Response, err error) {
+	if len(ips) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	joined := strings.Join(ips, ",")
+
+	if len(joined) > ipsLenLimit {
+		err = errBigRequest
+		return
+	}
+	params := make(url.Values)
+	params.Set("ips", joined)
+	resp, err = s.core.get(ctx, routes.DnsReverse, params, &result)
+	return
+}
+
+// DnsDomain returns a collection of historical NS records for domain
+func (s *DNSService) DnsDomain(ctx context.Context, domain string) (result models.Domain, resp *Response, err error) {
+	if domain == "" {
+		err = errors.New("domain is required")
+		return
+	}
+	route := fmt.Sprintf(routes.DnsDomain, domain)
+	resp, err = s.core.get(ctx, route, nil, &result)
+	return
+}
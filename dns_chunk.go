@@ -0,0 +1,181 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const defaultChunkConcurrency = 4
+
+// chunkConfig holds the tunables a ChunkOption can adjust.
+type chunkConfig struct {
+	concurrency int
+}
+
+// ChunkOption configures the behaviour of DnsResolveAll and DnsReverseAll.
+type ChunkOption func(*chunkConfig)
+
+// WithChunkConcurrency overrides the number of chunks that are resolved in
+// parallel. The default is 4.
+func WithChunkConcurrency(n int) ChunkOption {
+	return func(c *chunkConfig) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+func newChunkConfig(opts []ChunkOption) chunkConfig {
+	cfg := chunkConfig{concurrency: defaultChunkConcurrency}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// chunkByEncodedLength splits items into groups whose comma-joined length
+// stays under limit, matching the way DnsResolve/DnsReverse encode their
+// payload on the wire. It errors out if a single item's own length already
+// exceeds limit, since no chunk size could ever make that item fit.
+func chunkByEncodedLength(items []string, limit int) ([][]string, error) {
+	var chunks [][]string
+	var current []string
+	length := 0
+
+	for _, item := range items {
+		itemLen := len(item)
+		if itemLen > limit {
+			return nil, fmt.Errorf("%q is %d characters long, which exceeds the %d limit on its own: %w", item, itemLen, limit, errBigRequest)
+		}
+
+		sepLen := 0
+		if len(current) > 0 {
+			sepLen = 1
+		}
+
+		if len(current) > 0 && length+sepLen+itemLen > limit {
+			chunks = append(chunks, current)
+			current = nil
+			length = 0
+			sepLen = 0
+		}
+
+		current = append(current, item)
+		length += sepLen + itemLen
+	}
+
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+
+	return chunks, nil
+}
+
+// DnsResolveAll resolves an arbitrarily large list of hostnames by
+// partitioning it into chunks that each fit under hostnamesLenLimit and
+// fanning the calls out across a bounded worker pool. The returned map
+// merges every chunk's results; if some chunks fail, the rest still
+// populate the map and the failures are returned as a *multierror.Error.
+func (s *DNSService) DnsResolveAll(ctx context.Context, hostnames []string, opts ...ChunkOption) (map[string]string, error) {
+	if len(hostnames) == 0 {
+		return nil, errEmptyParams
+	}
+
+	cfg := newChunkConfig(opts)
+	chunks, err := chunkByEncodedLength(hostnames, hostnamesLenLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(hostnames))
+	var mu sync.Mutex
+	var merr *multierror.Error
+
+	runChunks(ctx, len(chunks), cfg.concurrency, func(i int) error {
+		chunk, _, err := s.DnsResolve(ctx, chunks[i])
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			merr = multierror.Append(merr, err)
+			return err
+		}
+		for k, v := range chunk {
+			result[k] = v
+		}
+		return nil
+	})
+
+	return result, merr.ErrorOrNil()
+}
+
+// DnsReverseAll resolves an arbitrarily large list of IPs by partitioning
+// it into chunks that each fit under ipsLenLimit and fanning the calls out
+// across a bounded worker pool. The returned map merges every chunk's
+// results; if some chunks fail, the rest still populate the map and the
+// failures are returned as a *multierror.Error.
+func (s *DNSService) DnsReverseAll(ctx context.Context, ips []string, opts ...ChunkOption) (map[string][]string, error) {
+	if len(ips) == 0 {
+		return nil, errEmptyParams
+	}
+
+	cfg := newChunkConfig(opts)
+	chunks, err := chunkByEncodedLength(ips, ipsLenLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string, len(ips))
+	var mu sync.Mutex
+	var merr *multierror.Error
+
+	runChunks(ctx, len(chunks), cfg.concurrency, func(i int) error {
+		chunk, _, err := s.DnsReverse(ctx, chunks[i])
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			merr = multierror.Append(merr, err)
+			return err
+		}
+		for k, v := range chunk {
+			result[k] = v
+		}
+		return nil
+	})
+
+	return result, merr.ErrorOrNil()
+}
+
+// runChunks fans work out across a worker pool capped at concurrency,
+// stopping early if ctx is cancelled. It blocks until every chunk has
+// either run or been skipped because of cancellation.
+func runChunks(ctx context.Context, n, concurrency int, work func(i int) error) {
+	if n == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				return
+			}
+			_ = work(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,40 @@
+package shodan
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+	"github.com/shadowscatcher/shodan/search"
+)
+
+// ExploitsService handles communication with the exploits.shodan.io API,
+// which is hosted separately from the rest of the Shodan endpoints.
+type ExploitsService struct {
+	core *core
+}
+
+// ExploitSearch allows to search across a variety of data sources for exploits and use facets to get summary information
+func (s *ExploitsService) ExploitSearch(ctx context.Context, params search.ExploitParams) (result models.ExploitResult, resp *Response, err error) {
+	values := params.ToURLValues()
+	if len(values) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	resp, err = s.core.requestExploits(ctx, http.MethodGet, routes.Search, values, nil, nil, &result)
+	return
+}
+
+// ExploitCount behaves identical to the exploits "/search" method with the difference that it doesn't return any results
+func (s *ExploitsService) ExploitCount(ctx context.Context, params search.ExploitParams) (result models.ExploitResult, resp *Response, err error) {
+	values := params.ToURLValues()
+	if len(values) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	resp, err = s.core.requestExploits(ctx, http.MethodGet, routes.Count, values, nil, nil, &result)
+	return
+}
@@ -0,0 +1,85 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+	"github.com/shadowscatcher/shodan/search"
+)
+
+var errEmptyParams = errors.New("empty parameters")
+
+// HostsService handles communication with the host-lookup and search
+// related endpoints, rooted at /shodan/host.
+type HostsService struct {
+	core *core
+}
+
+// Host returns all services that have been found on the given host IP
+func (s *HostsService) Host(ctx context.Context, params search.HostParams) (result models.Host, resp *Response, err error) {
+	route := fmt.Sprintf(routes.ShodanHostView, params.IP)
+	resp, err = s.core.get(ctx, route, params.ToURLValues(), &result)
+	return
+}
+
+// Count searches Shodan without results
+// This method behaves identical to Search() with the only difference that this method does not return any host results,
+// it only returns the total number of results that matched the query and any facet information that was requested.
+// As a result this method does not consume query credits.
+func (s *HostsService) Count(ctx context.Context, params search.Params) (result models.SearchResult, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanHostCount, params.ToURLValues(), &result)
+	return
+}
+
+// Search using the same query syntax as the website and use facets to get summary information for different properties
+// This method may use API query credits depending on usage.
+// If any of the following criteria are met, your account will be deducated 1 query credit:
+// * The search query contains a filter.
+// * Accessing results past the 1st page using the "page". For every 100 results past the 1st page 1 query credit
+// is deducted.
+func (s *HostsService) Search(ctx context.Context, params search.Params) (result models.SearchResult, resp *Response, err error) {
+	// todo: check: minify seems ignored
+	values := params.ToURLValues()
+	if len(values) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	resp, err = s.core.get(ctx, routes.ShodanHostSearch, values, &result)
+	return
+}
+
+// SearchTokens allows to break the search query into tokens
+// This method lets you determine which filters are being used by the query string and what parameters were provided
+// to the filters.
+func (s *HostsService) SearchTokens(ctx context.Context, params search.Params) (result models.Tokens, resp *Response, err error) {
+	values := params.ToURLValues()
+	if len(values) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	resp, err = s.core.get(ctx, routes.ShodanHostSearchTokens, values, &result)
+	return
+}
+
+// Ports returns a list of port numbers that the crawlers are looking for
+func (s *HostsService) Ports(ctx context.Context) (result []int, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanPorts, nil, &result)
+	return
+}
+
+// Protocols returns a map containing all the protocols that can be used when launching an Internet scan
+func (s *HostsService) Protocols(ctx context.Context) (result map[string]string, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanProtocols, nil, &result)
+	return
+}
+
+// Services returns a map containing all the services Shodan can detect
+func (s *HostsService) Services(ctx context.Context) (result map[string]string, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ShodanServices, nil, &result)
+	return
+}
@@ -0,0 +1,12 @@
+package shodan
+
+// PageInfo reports the iterator's position within a paginated listing, so
+// callers can drive progress reporting without tracking page math
+// themselves.
+type PageInfo struct {
+	// Page is the last page fetched from the API (1-indexed).
+	Page int
+	// Total is the total number of results across all pages, as reported
+	// by the API on the most recently fetched page.
+	Total int
+}
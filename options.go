@@ -0,0 +1,136 @@
+package shodan
+
+import (
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// toValues renders an opts struct's `url` struct tags into url.Values via
+// go-querystring, the same encoding search.Params.ToURLValues() uses for
+// the richer search filters. It returns an error if opts is not a struct
+// or pointer to one, which would be a programmer error in one of this
+// package's opts types.
+func toValues(opts interface{}) (url.Values, error) {
+	return query.Values(opts)
+}
+
+// ScanListOpts filters Client.Scans.ListScans.
+type ScanListOpts struct {
+	// Page selects which page of results to return; pages are 1-indexed
+	// and Shodan defaults to the first page when omitted.
+	Page uint `url:"page,omitempty"`
+}
+
+// ScanListOption configures a ScanListOpts.
+type ScanListOption func(*ScanListOpts)
+
+// WithScanPage sets the page of ScanListOpts.
+func WithScanPage(page uint) ScanListOption {
+	return func(o *ScanListOpts) { o.Page = page }
+}
+
+// NewScanListOpts builds a ScanListOpts from the given ScanListOption values.
+func NewScanListOpts(options ...ScanListOption) ScanListOpts {
+	var opts ScanListOpts
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+// QueryListOpts filters Client.Queries.QueryList.
+type QueryListOpts struct {
+	// Page selects which page of results to return; each page contains
+	// 10 items.
+	Page uint `url:"page,omitempty"`
+	// Sort orders the listing by a property. Possible values are: votes,
+	// timestamp.
+	Sort string `url:"sort,omitempty"`
+	// Order controls the sort direction. Possible values are: asc, desc.
+	Order string `url:"order,omitempty"`
+}
+
+// QueryListOption configures a QueryListOpts.
+type QueryListOption func(*QueryListOpts)
+
+// WithQueryListPage sets the page of QueryListOpts.
+func WithQueryListPage(page uint) QueryListOption {
+	return func(o *QueryListOpts) { o.Page = page }
+}
+
+// WithQueryListSort sets the sort property of QueryListOpts.
+func WithQueryListSort(sort string) QueryListOption {
+	return func(o *QueryListOpts) { o.Sort = sort }
+}
+
+// WithQueryListOrder sets the sort direction of QueryListOpts.
+func WithQueryListOrder(order string) QueryListOption {
+	return func(o *QueryListOpts) { o.Order = order }
+}
+
+// NewQueryListOpts builds a QueryListOpts from the given QueryListOption values.
+func NewQueryListOpts(options ...QueryListOption) QueryListOpts {
+	var opts QueryListOpts
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+// QueryTagsOpts filters Client.Queries.QueryTags.
+type QueryTagsOpts struct {
+	// Size is the number of tags to return.
+	Size uint `url:"size,omitempty"`
+}
+
+// QueryTagsOption configures a QueryTagsOpts.
+type QueryTagsOption func(*QueryTagsOpts)
+
+// WithQueryTagsSize sets the size of QueryTagsOpts.
+func WithQueryTagsSize(size uint) QueryTagsOption {
+	return func(o *QueryTagsOpts) { o.Size = size }
+}
+
+// NewQueryTagsOpts builds a QueryTagsOpts from the given QueryTagsOption values.
+func NewQueryTagsOpts(options ...QueryTagsOption) QueryTagsOpts {
+	var opts QueryTagsOpts
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+// AlertListOpts filters Client.Alerts.ListAlerts. Shodan does not currently
+// expose any query parameters on this endpoint, so it has no fields yet,
+// but exists so ListAlerts matches the opts-based shape of the other list
+// methods and can grow without a breaking signature change.
+type AlertListOpts struct{}
+
+// QuerySearchOpts filters Client.Queries.QuerySearch.
+type QuerySearchOpts struct {
+	// Query is the search term to look for in saved query titles and
+	// descriptions. Required.
+	Query string `url:"query"`
+	// Page selects which page of results to return; pages are 1-indexed
+	// and Shodan defaults to the first page when omitted.
+	Page uint `url:"page,omitempty"`
+}
+
+// QuerySearchOption configures a QuerySearchOpts.
+type QuerySearchOption func(*QuerySearchOpts)
+
+// WithQuerySearchPage sets the page of QuerySearchOpts.
+func WithQuerySearchPage(page uint) QuerySearchOption {
+	return func(o *QuerySearchOpts) { o.Page = page }
+}
+
+// NewQuerySearchOpts builds a QuerySearchOpts for query from the given
+// QuerySearchOption values.
+func NewQuerySearchOpts(query string, options ...QuerySearchOption) QuerySearchOpts {
+	opts := QuerySearchOpts{Query: query}
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
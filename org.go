@@ -0,0 +1,54 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+var errEmptyUsername = errors.New("empty username")
+
+// OrgService handles communication with the organization management
+// endpoints, rooted at /org.
+type OrgService struct {
+	core *core
+}
+
+// Org allows to get information about your organization such as the list of its members, upgrades, authorized domains and more
+func (s *OrgService) Org(ctx context.Context) (result models.Org, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.Org, nil, &result)
+	return
+}
+
+// AddOrgMember adds a Shodan user to the organization and upgrades them
+func (s *OrgService) AddOrgMember(ctx context.Context, username string, notify bool) (result models.SimpleResponse, resp *Response, err error) {
+	if username == "" {
+		err = errEmptyUsername
+		return
+	}
+
+	route := fmt.Sprintf(routes.OrgMember, username)
+	params := make(url.Values)
+	if notify {
+		params.Set("notify", "true")
+	}
+	resp, err = s.core.request(ctx, http.MethodPut, route, params, nil, nil, &result)
+	return
+}
+
+// DeleteOrgMember allows to remove and downgrade the provided member from the organization
+func (s *OrgService) DeleteOrgMember(ctx context.Context, username string) (result models.SimpleResponse, resp *Response, err error) {
+	if username == "" {
+		err = errEmptyUsername
+		return
+	}
+
+	route := fmt.Sprintf(routes.OrgMember, username)
+	resp, err = s.core.request(ctx, http.MethodDelete, route, nil, nil, nil, &result)
+	return
+}
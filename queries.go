@@ -0,0 +1,52 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// QueriesService handles communication with the saved search query
+// endpoints, rooted at /shodan/query.
+type QueriesService struct {
+	core *core
+}
+
+// QueryList use this method to obtain a list of search queries that users have saved in Shodan.
+func (s *QueriesService) QueryList(ctx context.Context, opts QueryListOpts) (result models.SearchQueries, resp *Response, err error) {
+	params, err := toValues(opts)
+	if err != nil {
+		return
+	}
+
+	resp, err = s.core.get(ctx, routes.ShodanQuery, params, &result)
+	return
+}
+
+// QuerySearch allows to search the directory of search queries that users have saved in Shodan
+func (s *QueriesService) QuerySearch(ctx context.Context, opts QuerySearchOpts) (result models.SearchQueries, resp *Response, err error) {
+	if opts.Query == "" {
+		err = errors.New("empty search query")
+		return
+	}
+
+	params, err := toValues(opts)
+	if err != nil {
+		return
+	}
+
+	resp, err = s.core.get(ctx, routes.ShodanQuerySearch, params, &result)
+	return
+}
+
+// QueryTags allows to obtain a list of popular tags for the saved search queries in Shodan
+func (s *QueriesService) QueryTags(ctx context.Context, opts QueryTagsOpts) (result models.QueryTags, resp *Response, err error) {
+	params, err := toValues(opts)
+	if err != nil {
+		return
+	}
+	resp, err = s.core.get(ctx, routes.ShodanQueryTags, params, &result)
+	return
+}
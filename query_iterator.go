@@ -0,0 +1,87 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/shadowscatcher/shodan/models"
+)
+
+// QueryIterator lazily walks every page of a saved-query listing, whether
+// it comes from QueryList or QuerySearch.
+type QueryIterator struct {
+	fetch func(ctx context.Context, page uint) (models.SearchQueries, *Response, error)
+	page  uint
+	total int
+	items []models.Query
+	idx   int
+	value models.Query
+	err   error
+	done  bool
+}
+
+// IterateList returns a QueryIterator over Client.Queries.QueryList, using
+// the given sort/order on every page it fetches.
+func (s *QueriesService) IterateList(ctx context.Context, sort, order string) *QueryIterator {
+	return &QueryIterator{
+		fetch: func(ctx context.Context, page uint) (models.SearchQueries, *Response, error) {
+			return s.QueryList(ctx, QueryListOpts{Page: page, Sort: sort, Order: order})
+		},
+	}
+}
+
+// IterateSearch returns a QueryIterator over Client.Queries.QuerySearch for
+// the given query string.
+func (s *QueriesService) IterateSearch(ctx context.Context, query string) *QueryIterator {
+	return &QueryIterator{
+		fetch: func(ctx context.Context, page uint) (models.SearchQueries, *Response, error) {
+			return s.QuerySearch(ctx, QuerySearchOpts{Query: query, Page: page})
+		},
+	}
+}
+
+// Next advances the iterator, fetching the next page from the API if the
+// current page has been fully consumed. It returns false once there are no
+// more results or an error occurred; check Err() to tell the two apart.
+func (it *QueryIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		it.page++
+		result, _, err := it.fetch(ctx, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.total = result.Total
+		it.items = result.Matches
+		it.idx = 0
+
+		if len(it.items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.value = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+// Value returns the saved query the iterator is currently positioned at.
+func (it *QueryIterator) Value() models.Query {
+	return it.value
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *QueryIterator) Err() error {
+	return it.err
+}
+
+// PageInfo reports the current page number and the total number of saved
+// queries reported by the API.
+func (it *QueryIterator) PageInfo() PageInfo {
+	return PageInfo{Page: int(it.page), Total: it.total}
+}
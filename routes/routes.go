@@ -36,8 +36,17 @@ const (
 	AccountProfile                       = "/account/profile"
 	DnsResolve                           = "/dns/resolve"
 	DnsReverse                           = "/dns/reverse"
+	DnsDomain                            = "/dns/domain/%s"
 	ToolsHTTPHeaders                     = "/tools/httpheaders"
 	ToolsMyIP                            = "/tools/myip"
 	ApiInfo                              = "/api-info"
 	LabsHoneyscore                       = "/labs/honeyscore/%s"
-)
\ No newline at end of file
+
+	StreamBanners          = "/shodan/banners"
+	StreamBannersPorts     = "/shodan/ports/%s"
+	StreamBannersASN       = "/shodan/asn/%s"
+	StreamBannersCountries = "/shodan/countries/%s"
+	StreamAlerts           = "/shodan/alert"
+	StreamAlert            = "/shodan/alert/%s"
+	StreamCustom           = "/shodan/%s"
+)
@@ -0,0 +1,73 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/shadowscatcher/shodan/models"
+)
+
+// ScanIterator lazily walks every page of Client.Scans.ListScans, fetching
+// the next page only once the caller has consumed the current one.
+type ScanIterator struct {
+	scans *ScansService
+	page  uint
+	total int
+	items []models.Scan
+	idx   int
+	value models.Scan
+	err   error
+	done  bool
+}
+
+// Iterate returns a ScanIterator over every scan on the account, fetching
+// pages on demand as the caller advances through it.
+func (s *ScansService) Iterate(ctx context.Context) *ScanIterator {
+	return &ScanIterator{scans: s}
+}
+
+// Next advances the iterator, fetching the next page from the API if the
+// current page has been fully consumed. It returns false once there are no
+// more results or an error occurred; check Err() to tell the two apart.
+func (it *ScanIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		it.page++
+		result, _, err := it.scans.ListScans(ctx, ScanListOpts{Page: it.page})
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.total = result.Total
+		it.items = result.Matches
+		it.idx = 0
+
+		if len(it.items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.value = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+// Value returns the scan the iterator is currently positioned at.
+func (it *ScanIterator) Value() models.Scan {
+	return it.value
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *ScanIterator) Err() error {
+	return it.err
+}
+
+// PageInfo reports the current page number and the total number of scans
+// reported by the API.
+func (it *ScanIterator) PageInfo() PageInfo {
+	return PageInfo{Page: int(it.page), Total: it.total}
+}
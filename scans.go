@@ -0,0 +1,86 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// ScansService handles communication with the on-demand scanning endpoints,
+// rooted at /shodan/scan.
+type ScansService struct {
+	core *core
+}
+
+// SubmitScan requests Shodan to crawl an IP/netblock
+// This method uses API scan credits: 1 IP consumes 1 scan credit. You must have a paid API plan
+// (either one-time payment or subscription) in order to use this method
+func (s *ScansService) SubmitScan(ctx context.Context, ips []string, force bool) (result models.Scan, resp *Response, err error) {
+	if len(ips) == 0 {
+		err = errEmptyParams
+		return
+	}
+
+	params := make(url.Values)
+	params.Set("ips", strings.Join(ips, ","))
+	if force {
+		params.Set("force", "true")
+	}
+
+	body := strings.NewReader(params.Encode())
+	header := make(http.Header)
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err = s.core.request(ctx, http.MethodPost, routes.ShodanScan, nil, body, header, &result)
+	return
+}
+
+// ListScans returns a list of all your scans
+func (s *ScansService) ListScans(ctx context.Context, opts ScanListOpts) (result models.ScanList, resp *Response, err error) {
+	params, err := toValues(opts)
+	if err != nil {
+		return
+	}
+	resp, err = s.core.get(ctx, routes.ShodanScans, params, &result)
+	return
+}
+
+// GetScan checks the progress of a previously submitted scan request
+func (s *ScansService) GetScan(ctx context.Context, scanID string) (result models.Scan, resp *Response, err error) {
+	if scanID == "" {
+		err = errors.New("empty scanID")
+		return
+	}
+
+	route := fmt.Sprintf(routes.ShodanScanView, scanID)
+	resp, err = s.core.request(ctx, http.MethodGet, route, nil, nil, nil, &result)
+	return
+}
+
+// ScanInternet use this method to request Shodan to crawl the Internet for a specific port.
+// This method is restricted to security researchers and companies with a Shodan Enterprise Data license. To apply
+// for access to this method as a researcher, please email jmath@shodan.io with information about your project.
+// Access is restricted to prevent abuse.
+func (s *ScansService) ScanInternet(ctx context.Context, port uint16, protocol string) (result models.Scan, resp *Response, err error) {
+	if protocol == "" {
+		err = errors.New("empty protocol")
+		return
+	}
+
+	params := make(url.Values)
+	params.Set("port", fmt.Sprint(port))
+	params.Set("protocol", protocol)
+
+	body := strings.NewReader(params.Encode())
+	header := make(http.Header)
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err = s.core.request(ctx, http.MethodPost, routes.ShodanScanInternet, nil, body, header, &result)
+	return
+}
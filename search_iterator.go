@@ -0,0 +1,85 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/search"
+)
+
+// SearchIterator lazily walks every page of Client.Hosts.Search, advancing
+// params.Page on each fetch.
+type SearchIterator struct {
+	hosts   *HostsService
+	params  search.Params
+	fetched bool
+	total   int
+	items   []models.Host
+	idx     int
+	value   models.Host
+	err     error
+	done    bool
+}
+
+// Iterate returns a SearchIterator over every result of a Search query,
+// starting at whatever page params.Page is set to (params.Page is
+// incremented on every subsequent fetch).
+func (s *HostsService) Iterate(ctx context.Context, params search.Params) *SearchIterator {
+	return &SearchIterator{hosts: s, params: params}
+}
+
+// Next advances the iterator, fetching the next page from the API if the
+// current page has been fully consumed. It returns false once there are no
+// more results or an error occurred; check Err() to tell the two apart.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		switch {
+		case !it.fetched:
+			if it.params.Page == 0 {
+				it.params.Page = 1
+			}
+			it.fetched = true
+		default:
+			it.params.Page++
+		}
+
+		result, _, err := it.hosts.Search(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.total = result.Total
+		it.items = result.Matches
+		it.idx = 0
+
+		if len(it.items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.value = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+// Value returns the host the iterator is currently positioned at.
+func (it *SearchIterator) Value() models.Host {
+	return it.value
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// PageInfo reports the current page number and the total number of matches
+// reported by the API.
+func (it *SearchIterator) PageInfo() PageInfo {
+	return PageInfo{Page: int(it.params.Page), Total: it.total}
+}
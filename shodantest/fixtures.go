@@ -0,0 +1,109 @@
+package shodantest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// Example IDs used throughout DefaultFixtures, exported so tests built on
+// top of DefaultFixtures know which values to call the client with.
+const (
+	ExampleIP       = "1.1.1.1"
+	ExampleScanID   = "scan-1"
+	ExampleAlertID  = "alert-1"
+	ExampleTrigger  = "open_database"
+	ExampleIgnore   = "1.1.1.1:443"
+	ExampleUsername = "member-1"
+	ExampleDataset  = "dataset-1"
+	ExampleDomain   = "example.com"
+)
+
+// DefaultFixtures returns one Fixture per route in routes/routes.go (except
+// routes.StreamCustom, whose path is chosen by the caller), enough
+// to exercise every method of every shodan.Client sub-service plus the
+// Streaming API end to end. Tests that need a specific response shape
+// should override the relevant entry or append their own Fixture instead
+// of relying on these defaults staying byte-for-byte stable.
+func DefaultFixtures() []Fixture {
+	return []Fixture{
+		// Hosts
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/host/%s", ExampleIP), Body: map[string]interface{}{
+			"ip_str": ExampleIP, "ports": []int{53, 443},
+		}},
+		{Method: http.MethodGet, Path: routes.ShodanHostCount, Body: map[string]interface{}{"total": 1, "matches": []interface{}{}}},
+		{Method: http.MethodGet, Path: routes.ShodanHostSearch, Body: map[string]interface{}{
+			"total": 1, "matches": []map[string]interface{}{{"ip_str": ExampleIP}},
+		}},
+		{Method: http.MethodGet, Path: routes.ShodanHostSearchTokens, Body: map[string]interface{}{
+			"attributes": map[string]interface{}{}, "filters": []string{}, "string": "test",
+		}},
+		{Method: http.MethodGet, Path: routes.ShodanPorts, Body: []int{21, 22, 443}},
+		{Method: http.MethodGet, Path: routes.ShodanServices, Body: map[string]string{"443": "HTTPS"}},
+		{Method: http.MethodGet, Path: routes.ShodanProtocols, Body: map[string]string{"https": "HTTPS protocol"}},
+
+		// Scans
+		{Method: http.MethodPost, Path: routes.ShodanScan, Body: map[string]interface{}{"id": ExampleScanID, "count": 1}},
+		{Method: http.MethodGet, Path: routes.ShodanScans, Body: map[string]interface{}{
+			"total": 1, "matches": []map[string]interface{}{{"id": ExampleScanID}},
+		}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/scan/%s", ExampleScanID), Body: map[string]interface{}{"id": ExampleScanID, "status": "DONE"}},
+		{Method: http.MethodPost, Path: routes.ShodanScanInternet, Body: map[string]interface{}{"id": ExampleScanID}},
+
+		// Queries
+		{Method: http.MethodGet, Path: routes.ShodanQuery, Body: map[string]interface{}{
+			"total": 1, "matches": []map[string]interface{}{{"title": "test-query"}},
+		}},
+		{Method: http.MethodGet, Path: routes.ShodanQuerySearch, Body: map[string]interface{}{
+			"total": 1, "matches": []map[string]interface{}{{"title": "test-query"}},
+		}},
+		{Method: http.MethodGet, Path: routes.ShodanQueryTags, Body: map[string]interface{}{
+			"total": 1, "matches": []map[string]interface{}{{"value": "webcam", "count": 10}},
+		}},
+
+		// Alerts
+		{Method: http.MethodPost, Path: routes.ShodanAlert, Body: map[string]interface{}{"id": ExampleAlertID, "name": "test-alert"}},
+		{Method: http.MethodGet, Path: routes.ShodanAlertInfo, Body: []map[string]interface{}{{"id": ExampleAlertID, "name": "test-alert"}}},
+		{Method: http.MethodDelete, Path: fmt.Sprintf("/shodan/alert/%s", ExampleAlertID), Body: map[string]interface{}{}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/alert/%s/info", ExampleAlertID), Body: map[string]interface{}{"id": ExampleAlertID, "name": "test-alert"}},
+		{Method: http.MethodGet, Path: routes.ShodanAlertTriggers, Body: []map[string]interface{}{{"name": ExampleTrigger}}},
+		{Method: http.MethodPut, Path: fmt.Sprintf("/shodan/alert/%s/trigger/%s", ExampleAlertID, ExampleTrigger), Body: map[string]interface{}{"success": true}},
+		{Method: http.MethodDelete, Path: fmt.Sprintf("/shodan/alert/%s/trigger/%s", ExampleAlertID, ExampleTrigger), Body: map[string]interface{}{"success": true}},
+		{Method: http.MethodPut, Path: fmt.Sprintf("/shodan/alert/%s/trigger/%s/ignore/%s", ExampleAlertID, ExampleTrigger, ExampleIgnore), Body: map[string]interface{}{"success": true}},
+		{Method: http.MethodDelete, Path: fmt.Sprintf("/shodan/alert/%s/trigger/%s/ignore/%s", ExampleAlertID, ExampleTrigger, ExampleIgnore), Body: map[string]interface{}{"success": true}},
+
+		// Data
+		{Method: http.MethodGet, Path: routes.ShodanData, Body: []map[string]interface{}{{"name": ExampleDataset}}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/data/%s", ExampleDataset), Body: []map[string]interface{}{{"name": "file-1.json.gz"}}},
+
+		// Org
+		{Method: http.MethodGet, Path: routes.Org, Body: map[string]interface{}{"name": "test-org"}},
+		{Method: http.MethodPut, Path: fmt.Sprintf("/org/member/%s", ExampleUsername), Body: map[string]interface{}{"success": true}},
+		{Method: http.MethodDelete, Path: fmt.Sprintf("/org/member/%s", ExampleUsername), Body: map[string]interface{}{"success": true}},
+
+		// Tools / account / labs
+		{Method: http.MethodGet, Path: routes.AccountProfile, Body: map[string]interface{}{"display_name": "test-user"}},
+		{Method: http.MethodGet, Path: routes.ToolsHTTPHeaders, Body: map[string]string{"User-Agent": "shodan-go"}},
+		{Method: http.MethodGet, Path: routes.ToolsMyIP, Body: ExampleIP},
+		{Method: http.MethodGet, Path: routes.ApiInfo, Body: map[string]interface{}{"plan": "dev"}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/labs/honeyscore/%s", ExampleIP), Body: 0.5},
+
+		// DNS
+		{Method: http.MethodGet, Path: routes.DnsResolve, Body: map[string]string{ExampleDomain: ExampleIP}},
+		{Method: http.MethodGet, Path: routes.DnsReverse, Body: map[string][]string{ExampleIP: {ExampleDomain}}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/dns/domain/%s", ExampleDomain), Body: map[string]interface{}{"domain": ExampleDomain, "subdomains": []string{}}},
+
+		// Exploits (served on the same mock server as the rest in tests)
+		{Method: http.MethodGet, Path: routes.Search, Body: map[string]interface{}{"total": 1, "matches": []interface{}{}}},
+		{Method: http.MethodGet, Path: routes.Count, Body: map[string]interface{}{"total": 1, "matches": []interface{}{}}},
+
+		// Streams
+		{Method: http.MethodGet, Path: routes.StreamBanners, Body: map[string]interface{}{"ip_str": ExampleIP}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/ports/%d", 443), Body: map[string]interface{}{"ip_str": ExampleIP}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/asn/%s", "AS1"), Body: map[string]interface{}{"ip_str": ExampleIP}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/countries/%s", "US"), Body: map[string]interface{}{"ip_str": ExampleIP}},
+		{Method: http.MethodGet, Path: routes.StreamAlerts, Body: map[string]interface{}{"ip_str": ExampleIP}},
+		{Method: http.MethodGet, Path: fmt.Sprintf("/shodan/alert/%s", ExampleAlertID), Body: map[string]interface{}{"ip_str": ExampleIP}},
+	}
+}
@@ -0,0 +1,141 @@
+// Package shodantest spins up an httptest.Server preloaded with canned
+// responses for Shodan's routes, so downstream consumers (and this
+// module's own tests) can exercise the client without hitting the real
+// API.
+package shodantest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/shadowscatcher/shodan"
+)
+
+// TestKey is the API key every fixture client is authenticated with.
+const TestKey = "test-key"
+
+// Fixture describes a canned response for one route, plus optional request
+// assertions run when that route is hit.
+type Fixture struct {
+	// Method is the HTTP method this fixture responds to, e.g. http.MethodGet.
+	Method string
+	// Path is the request path this fixture responds to, e.g. "/shodan/host/1.1.1.1".
+	Path string
+	// Status is the HTTP status code to respond with. Defaults to 200.
+	Status int
+	// Body is marshaled to JSON and written as the response body.
+	Body interface{}
+	// AssertRequest, if set, is called with the incoming request before
+	// the canned response is written, letting callers check query params,
+	// headers, or the request body.
+	AssertRequest func(t *testing.T, r *http.Request)
+}
+
+// Server wraps an httptest.Server preloaded with Fixtures, recording every
+// request it receives so tests can assert on call counts.
+type Server struct {
+	*httptest.Server
+
+	t        *testing.T
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// NewServer starts an httptest.Server that serves the given fixtures,
+// keyed by "METHOD PATH". A request to a route with no matching fixture
+// fails the test.
+func NewServer(t *testing.T, fixtures []Fixture) *Server {
+	byRoute := make(map[string]Fixture, len(fixtures))
+	for _, f := range fixtures {
+		status := f.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		f.Status = status
+		byRoute[f.Method+" "+f.Path] = f
+	}
+
+	s := &Server{t: t}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.mu.Unlock()
+
+		key := r.Method + " " + r.URL.Path
+		f, ok := byRoute[key]
+		if !ok {
+			t.Errorf("shodantest: no fixture registered for %s", key)
+			http.NotFound(w, r)
+			return
+		}
+
+		if f.AssertRequest != nil {
+			f.AssertRequest(t, r)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(f.Status)
+		if f.Body != nil {
+			if err := json.NewEncoder(w).Encode(f.Body); err != nil {
+				t.Fatalf("shodantest: encode response body: %v", err)
+			}
+		}
+	}))
+
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Client builds a *shodan.Client pointed at the server, authenticated with
+// TestKey.
+func (s *Server) Client() *shodan.Client {
+	return shodan.NewClient(TestKey, s.Server.Client(),
+		shodan.WithBaseURL(s.Server.URL),
+		shodan.WithExploitsURL(s.Server.URL),
+		shodan.WithStreamURL(s.Server.URL),
+	)
+}
+
+// Requests returns every request the server has received so far, in order.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// ReadBody reads and returns r's body, failing the test if it can't be
+// read. Intended for use inside a Fixture.AssertRequest callback.
+func ReadBody(t *testing.T, r *http.Request) []byte {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("shodantest: read request body: %v", err)
+	}
+	return body
+}
+
+// AssertQueryParam is a convenience AssertRequest helper that fails the
+// test if the given query parameter isn't set to want.
+func AssertQueryParam(name, want string) func(t *testing.T, r *http.Request) {
+	return func(t *testing.T, r *http.Request) {
+		got := r.URL.Query().Get(name)
+		if got != want {
+			t.Errorf("shodantest: query param %q = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// NotFoundFixture builds a Fixture that responds with a 404 and the given
+// message, for exercising error handling paths.
+func NotFoundFixture(method, path, message string) Fixture {
+	return Fixture{
+		Method: method,
+		Path:   path,
+		Status: http.StatusNotFound,
+		Body:   map[string]string{"error": message},
+	}
+}
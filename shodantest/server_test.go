@@ -0,0 +1,226 @@
+package shodantest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shadowscatcher/shodan"
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/search"
+)
+
+func TestServer_TableDriven(t *testing.T) {
+	cases := []struct {
+		name string
+		call func(t *testing.T, client *shodan.Client) error
+	}{
+		{"Hosts.Host", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Host(context.Background(), search.HostParams{IP: ExampleIP})
+			return err
+		}},
+		{"Hosts.Count", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Count(context.Background(), search.Params{Query: "test"})
+			return err
+		}},
+		{"Hosts.Search", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Search(context.Background(), search.Params{Query: "test"})
+			return err
+		}},
+		{"Hosts.SearchTokens", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.SearchTokens(context.Background(), search.Params{Query: "test"})
+			return err
+		}},
+		{"Hosts.Ports", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Ports(context.Background())
+			return err
+		}},
+		{"Hosts.Services", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Services(context.Background())
+			return err
+		}},
+		{"Hosts.Protocols", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Hosts.Protocols(context.Background())
+			return err
+		}},
+		{"Scans.SubmitScan", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Scans.SubmitScan(context.Background(), []string{ExampleIP}, false)
+			return err
+		}},
+		{"Scans.ListScans", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Scans.ListScans(context.Background(), shodan.ScanListOpts{})
+			return err
+		}},
+		{"Scans.GetScan", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Scans.GetScan(context.Background(), ExampleScanID)
+			return err
+		}},
+		{"Scans.ScanInternet", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Scans.ScanInternet(context.Background(), 443, "https")
+			return err
+		}},
+		{"Queries.QueryList", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Queries.QueryList(context.Background(), shodan.QueryListOpts{})
+			return err
+		}},
+		{"Queries.QuerySearch", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Queries.QuerySearch(context.Background(), shodan.QuerySearchOpts{Query: "webcam"})
+			return err
+		}},
+		{"Queries.QueryTags", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Queries.QueryTags(context.Background(), shodan.QueryTagsOpts{})
+			return err
+		}},
+		{"Alerts.CreateAlert", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.CreateAlert(context.Background(), models.Alert{Name: "test-alert"})
+			return err
+		}},
+		{"Alerts.ListAlerts", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.ListAlerts(context.Background(), shodan.AlertListOpts{})
+			return err
+		}},
+		{"Alerts.DeleteAlert", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.DeleteAlert(context.Background(), ExampleAlertID)
+			return err
+		}},
+		{"Alerts.AlertInfo", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.AlertInfo(context.Background(), ExampleAlertID)
+			return err
+		}},
+		{"Alerts.ListTriggers", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.ListTriggers(context.Background())
+			return err
+		}},
+		{"Alerts.CreateAlertTrigger", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.CreateAlertTrigger(context.Background(), ExampleAlertID, ExampleTrigger)
+			return err
+		}},
+		{"Alerts.DeleteAlertTrigger", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.DeleteAlertTrigger(context.Background(), ExampleAlertID, ExampleTrigger)
+			return err
+		}},
+		{"Alerts.CreateTriggerIgnore", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.CreateTriggerIgnore(context.Background(), ExampleAlertID, ExampleTrigger, ExampleIgnore)
+			return err
+		}},
+		{"Alerts.DeleteTriggerIgnore", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Alerts.DeleteTriggerIgnore(context.Background(), ExampleAlertID, ExampleTrigger, ExampleIgnore)
+			return err
+		}},
+		{"Data.Datasets", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Data.Datasets(context.Background())
+			return err
+		}},
+		{"Data.DatasetFiles", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Data.DatasetFiles(context.Background(), ExampleDataset)
+			return err
+		}},
+		{"Org.Org", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Org.Org(context.Background())
+			return err
+		}},
+		{"Org.AddOrgMember", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Org.AddOrgMember(context.Background(), ExampleUsername, false)
+			return err
+		}},
+		{"Org.DeleteOrgMember", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Org.DeleteOrgMember(context.Background(), ExampleUsername)
+			return err
+		}},
+		{"Tools.AccountProfile", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Tools.AccountProfile(context.Background())
+			return err
+		}},
+		{"Tools.HttpHeaders", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Tools.HttpHeaders(context.Background())
+			return err
+		}},
+		{"Tools.MyIP", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Tools.MyIP(context.Background())
+			return err
+		}},
+		{"Tools.ApiInfo", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Tools.ApiInfo(context.Background())
+			return err
+		}},
+		{"Tools.Honeyscore", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Tools.Honeyscore(context.Background(), ExampleIP)
+			return err
+		}},
+		{"DNS.DnsResolve", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.DNS.DnsResolve(context.Background(), []string{ExampleDomain})
+			return err
+		}},
+		{"DNS.DnsReverse", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.DNS.DnsReverse(context.Background(), []string{ExampleIP})
+			return err
+		}},
+		{"DNS.DnsDomain", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.DNS.DnsDomain(context.Background(), ExampleDomain)
+			return err
+		}},
+		{"Exploits.ExploitSearch", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Exploits.ExploitSearch(context.Background(), search.ExploitParams{Query: "test"})
+			return err
+		}},
+		{"Exploits.ExploitCount", func(t *testing.T, c *shodan.Client) error {
+			_, _, err := c.Exploits.ExploitCount(context.Background(), search.ExploitParams{Query: "test"})
+			return err
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewServer(t, DefaultFixtures())
+			if err := tc.call(t, server.Client()); err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if len(server.Requests()) != 1 {
+				t.Fatalf("%s: got %d requests, want 1", tc.name, len(server.Requests()))
+			}
+		})
+	}
+}
+
+func TestServer_AssertRequest(t *testing.T) {
+	fixtures := []Fixture{
+		{
+			Method:        http.MethodGet,
+			Path:          "/shodan/host/8.8.8.8",
+			Body:          map[string]string{"ip_str": "8.8.8.8"},
+			AssertRequest: AssertQueryParam("key", TestKey),
+		},
+	}
+
+	server := NewServer(t, fixtures)
+	_, _, err := server.Client().Hosts.Host(context.Background(), search.HostParams{IP: "8.8.8.8"})
+	if err != nil {
+		t.Fatalf("Host: %v", err)
+	}
+}
+
+func TestServer_Stream(t *testing.T) {
+	server := NewServer(t, DefaultFixtures())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	records, errs := server.Client().Stream.Banners(ctx)
+
+	select {
+	case host, ok := <-records:
+		if !ok {
+			t.Fatal("records channel closed before yielding a record")
+		}
+		if host.IP != ExampleIP {
+			t.Errorf("got IP %q, want %q", host.IP, ExampleIP)
+		}
+	case err := <-errs:
+		t.Fatalf("Banners: %v", err)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for a streamed record")
+	}
+
+	cancel()
+}
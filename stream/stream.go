@@ -0,0 +1,291 @@
+// Package stream implements a client for the Shodan Streaming API
+// (https://stream.shodan.io), which pushes newline-delimited JSON records
+// over long-lived HTTP connections instead of requiring callers to poll.
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// StreamOptions tunes the reconnect behaviour and buffering of a Client.
+type StreamOptions struct {
+	// InitialBackoff is the delay before the first reconnect attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the doubled backoff between reconnect attempts; the
+	// jitter added on top of it can take a delay up to 20% past MaxBackoff.
+	MaxBackoff time.Duration
+	// BufferSize sets the capacity of the channels returned by the
+	// consumer methods.
+	BufferSize int
+	// OnReconnect, if set, is called every time the stream reconnects
+	// after a transient failure, letting callers hook in observability.
+	OnReconnect func(attempt int, err error)
+}
+
+// DefaultStreamOptions returns the tunables used when a Client is built
+// without explicit StreamOptions.
+func DefaultStreamOptions() StreamOptions {
+	return StreamOptions{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		BufferSize:     100,
+	}
+}
+
+// Client consumes one or more Shodan streams over NDJSON.
+type Client struct {
+	key        string
+	baseURL    string
+	httpClient *http.Client
+	opts       StreamOptions
+}
+
+// ClientOption configures a Client at construction time.
+type ClientOption func(*Client)
+
+// WithBaseURL overrides the base URL for the Streaming API, pointing the
+// Client at something other than routes.ApiStream. This is mainly useful
+// for tests, e.g. to run against an httptest.Server.
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) { c.baseURL = url }
+}
+
+// NewClient builds a streaming Client authenticated with the given Shodan
+// API key.
+func NewClient(key string, httpClient *http.Client, opts StreamOptions, clientOpts ...ClientOption) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if opts.InitialBackoff == 0 {
+		opts.InitialBackoff = DefaultStreamOptions().InitialBackoff
+	}
+	if opts.MaxBackoff == 0 {
+		opts.MaxBackoff = DefaultStreamOptions().MaxBackoff
+	}
+	if opts.BufferSize == 0 {
+		opts.BufferSize = DefaultStreamOptions().BufferSize
+	}
+
+	c := &Client{
+		key:        key,
+		baseURL:    routes.ApiStream,
+		httpClient: httpClient,
+		opts:       opts,
+	}
+
+	for _, opt := range clientOpts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Banners streams every banner Shodan discovers in real time.
+func (c *Client) Banners(ctx context.Context) (<-chan models.Host, <-chan error) {
+	return c.consume(ctx, routes.StreamBanners, nil)
+}
+
+// BannersPorts streams banners for the given list of ports only.
+func (c *Client) BannersPorts(ctx context.Context, ports []int) (<-chan models.Host, <-chan error) {
+	strs := make([]string, len(ports))
+	for i, p := range ports {
+		strs[i] = strconv.Itoa(p)
+	}
+	route := fmt.Sprintf(routes.StreamBannersPorts, strings.Join(strs, ","))
+	return c.consume(ctx, route, nil)
+}
+
+// BannersASN streams banners discovered for the given list of ASNs.
+func (c *Client) BannersASN(ctx context.Context, asns []string) (<-chan models.Host, <-chan error) {
+	route := fmt.Sprintf(routes.StreamBannersASN, strings.Join(asns, ","))
+	return c.consume(ctx, route, nil)
+}
+
+// BannersCountries streams banners discovered for the given list of
+// 2-letter country codes.
+func (c *Client) BannersCountries(ctx context.Context, countries []string) (<-chan models.Host, <-chan error) {
+	route := fmt.Sprintf(routes.StreamBannersCountries, strings.Join(countries, ","))
+	return c.consume(ctx, route, nil)
+}
+
+// AlertsStream streams real-time results that match any of the account's
+// network alerts.
+func (c *Client) AlertsStream(ctx context.Context) (<-chan models.Host, <-chan error) {
+	return c.consume(ctx, routes.StreamAlerts, nil)
+}
+
+// AlertStream streams real-time results that match a single network alert.
+func (c *Client) AlertStream(ctx context.Context, alertID string) (<-chan models.Host, <-chan error) {
+	route := fmt.Sprintf(routes.StreamAlert, alertID)
+	return c.consume(ctx, route, nil)
+}
+
+// CustomStream consumes an arbitrary Shodan stream route, e.g. for
+// endpoints this package doesn't wrap yet.
+func (c *Client) CustomStream(ctx context.Context, route string, params url.Values) (<-chan models.Host, <-chan error) {
+	return c.consume(ctx, fmt.Sprintf(routes.StreamCustom, strings.TrimPrefix(route, "/")), params)
+}
+
+// consume opens route as an NDJSON stream and decodes records of type
+// models.Host from it onto the returned channel, reconnecting with
+// exponential backoff on transient failures. Both channels are closed once
+// ctx is done.
+func (c *Client) consume(ctx context.Context, route string, params url.Values) (<-chan models.Host, <-chan error) {
+	records := make(chan models.Host, c.opts.BufferSize)
+	errs := make(chan error, c.opts.BufferSize)
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		backoff := c.opts.InitialBackoff
+		attempt := 0
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			body, err := c.open(ctx, route, params)
+			if err != nil {
+				attempt++
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+					return
+				}
+				if c.opts.OnReconnect != nil {
+					c.opts.OnReconnect(attempt, err)
+				}
+				if !sleep(ctx, backoff) {
+					return
+				}
+				backoff = nextBackoff(backoff, c.opts.MaxBackoff)
+				continue
+			}
+
+			// A clean read loop resets the backoff: the connection was
+			// established and we only fell out of it once it ended.
+			readErr := decodeNDJSON(ctx, body, records, errs)
+			_ = body.Close()
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			attempt++
+			if c.opts.OnReconnect != nil {
+				c.opts.OnReconnect(attempt, readErr)
+			}
+			if !sleep(ctx, backoff) {
+				return
+			}
+			backoff = c.opts.InitialBackoff
+		}
+	}()
+
+	return records, errs
+}
+
+func (c *Client) open(ctx context.Context, route string, params url.Values) (io.ReadCloser, error) {
+	if params == nil {
+		params = make(url.Values)
+	}
+	params.Set("key", c.key)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+route+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("stream: GET %s: unexpected status %d", route, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// decodeNDJSON reads newline-delimited JSON host records from body, one line
+// at a time, until it is exhausted or ctx is cancelled. Records that fail to
+// decode are reported on errs without stopping the stream.
+func decodeNDJSON(ctx context.Context, body io.Reader, records chan<- models.Host, errs chan<- error) error {
+	reader := bufio.NewReader(body)
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		line, readErr := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) == 0 {
+			if readErr == io.EOF {
+				return nil
+			}
+			if readErr != nil {
+				return readErr
+			}
+			continue
+		}
+
+		var host models.Host
+		err := json.NewDecoder(bytes.NewReader(line)).Decode(&host)
+		if err != nil {
+			select {
+			case errs <- fmt.Errorf("stream: decode record: %w", err):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+
+		select {
+		case records <- host:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// nextBackoff doubles d, caps it at max, and jitters it by up to 20% so
+// that many reconnecting clients don't thunder against the API at once.
+func nextBackoff(d, max time.Duration) time.Duration {
+	d *= 2
+	if d > max {
+		d = max
+	}
+	jitter := time.Duration(rand.Int63n(int64(d)/5 + 1))
+	return d + jitter
+}
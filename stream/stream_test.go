@@ -0,0 +1,117 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testOptions() StreamOptions {
+	return StreamOptions{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		BufferSize:     10,
+	}
+}
+
+// TestClient_Banners_Reconnects verifies that a transient failure on the
+// first connection attempt is surfaced on errs, and that the stream
+// reconnects and keeps delivering records afterwards.
+func TestClient_Banners_Reconnects(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		fmt.Fprintln(w, `{"ip_str":"1.1.1.1"}`)
+	}))
+	defer server.Close()
+
+	c := NewClient("test-key", server.Client(), testOptions(), WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	records, errs := c.Banners(ctx)
+
+	sawErr := false
+	for {
+		select {
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if err != nil {
+				sawErr = true
+			}
+		case host, ok := <-records:
+			if !ok {
+				t.Fatal("records channel closed before yielding a record")
+			}
+			if !sawErr {
+				t.Fatal("got a record before the first connection attempt failed")
+			}
+			if host.IP != "1.1.1.1" {
+				t.Errorf("got IP %q, want %q", host.IP, "1.1.1.1")
+			}
+			cancel()
+			return
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for reconnect and a streamed record")
+		}
+	}
+}
+
+// TestClient_Banners_DecodeErrorDoesNotCloseStream verifies that a
+// malformed NDJSON record surfaces on errs without closing records, and
+// that well-formed records on either side of it still get delivered.
+func TestClient_Banners_DecodeErrorDoesNotCloseStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		fmt.Fprintln(w, `{"ip_str":"1.1.1.1"}`)
+		fmt.Fprintln(w, `not valid json`)
+		fmt.Fprintln(w, `{"ip_str":"2.2.2.2"}`)
+	}))
+	defer server.Close()
+
+	c := NewClient("test-key", server.Client(), testOptions(), WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	records, errs := c.Banners(ctx)
+
+	var gotIPs []string
+	gotDecodeErr := false
+
+	for len(gotIPs) < 2 {
+		select {
+		case err := <-errs:
+			if err != nil {
+				gotDecodeErr = true
+			}
+		case host, ok := <-records:
+			if !ok {
+				t.Fatal("records channel closed before yielding both records")
+			}
+			gotIPs = append(gotIPs, host.IP)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for records around the malformed line")
+		}
+	}
+
+	if !gotDecodeErr {
+		t.Error("expected a decode error on errs for the malformed line")
+	}
+	if len(gotIPs) != 2 || gotIPs[0] != "1.1.1.1" || gotIPs[1] != "2.2.2.2" {
+		t.Errorf("got IPs %v, want [1.1.1.1 2.2.2.2]", gotIPs)
+	}
+}
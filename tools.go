@@ -0,0 +1,52 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shadowscatcher/shodan/models"
+	"github.com/shadowscatcher/shodan/routes"
+)
+
+// ToolsService handles communication with the miscellaneous utility
+// endpoints: tools, account, api-info and labs.
+type ToolsService struct {
+	core *core
+}
+
+// HttpHeaders shows the HTTP headers that your client sends when connecting to a webserver
+func (s *ToolsService) HttpHeaders(ctx context.Context) (result map[string]string, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ToolsHTTPHeaders, nil, &result)
+	return
+}
+
+// MyIP allows to get your current IP address as seen from the Internet
+func (s *ToolsService) MyIP(ctx context.Context) (result string, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ToolsMyIP, nil, &result)
+	return
+}
+
+// AccountProfile returns information about the Shodan account linked to this API key
+func (s *ToolsService) AccountProfile(ctx context.Context) (result models.Profile, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.AccountProfile, nil, &result)
+	return
+}
+
+// ApiInfo returns information about the API plan belonging to the given API key
+func (s *ToolsService) ApiInfo(ctx context.Context) (result models.ApiInfo, resp *Response, err error) {
+	resp, err = s.core.get(ctx, routes.ApiInfo, nil, &result)
+	return
+}
+
+// Honeyscore calculates a honeypot probability score ranging from 0 (not a honeypot) to 1.0 (is a honeypot)
+func (s *ToolsService) Honeyscore(ctx context.Context, ip string) (result float32, resp *Response, err error) {
+	if ip == "" {
+		err = errors.New("ip is required")
+		return
+	}
+
+	route := fmt.Sprintf(routes.LabsHoneyscore, ip)
+	resp, err = s.core.get(ctx, route, nil, &result)
+	return
+}